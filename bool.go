@@ -0,0 +1,128 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Bool is a nullable bool.
+type Bool struct {
+	sql.NullBool
+}
+
+// NewBool creates a new Bool
+func NewBool(b bool, valid bool) Bool {
+	return Bool{
+		NullBool: sql.NullBool{
+			Bool:  b,
+			Valid: valid,
+		},
+	}
+}
+
+// BoolFrom creates a new Bool that will be null if b is false.
+func BoolFrom(b bool) Bool {
+	return NewBool(b, b)
+}
+
+// BoolFromPtr creates a new Bool that be null if b is nil or false.
+// The returned Bool holds a copy of *b.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return NewBool(false, false)
+	}
+	return BoolFrom(*b)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode false when this Bool is null.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("false"), nil
+	}
+	return json.Marshal(b.Bool)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports bool and null input. False input produces a null Bool,
+// unless Strict (or UnmarshalJSONStrict) says otherwise. It also supports
+// unmarshalling a sql.NullBool.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	return b.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats false as valid
+// rather than null, regardless of the package-level Strict setting.
+func (b *Bool) UnmarshalJSONStrict(data []byte) error {
+	return b.unmarshalJSON(data, true)
+}
+
+func (b *Bool) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case bool:
+		b.Bool = x
+		b.Valid = strict || b.Bool
+		return nil
+	case map[string]interface{}:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&b.NullBool); err != nil {
+			return err
+		}
+		b.Valid = b.Valid && (strict || b.Bool)
+		return nil
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*b))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode false when this Bool is null.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte("false"), nil
+	}
+	return []byte(strconv.FormatBool(b.Bool)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bool if the input is a blank or false string.
+func (b *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.Bool = false
+		b.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return err
+	}
+	b.Bool = v
+	b.Valid = b.Bool
+	return nil
+}
+
+// Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
+func (b Bool) Ptr() *bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bool
+}
+
+// IsZero returns true for null or false Bools, for future omitempty support.
+func (b Bool) IsZero() bool {
+	return !b.Valid || !b.Bool
+}
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBoolRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "false"},
+		{"false", "false", false, "false"},
+		{"true", "true", true, "true"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b Bool
+			if err := json.Unmarshal([]byte(c.input), &b); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if b.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", b.Valid, c.valid)
+			}
+			out, err := json.Marshal(b)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestBoolFromPtr(t *testing.T) {
+	if b := BoolFromPtr(nil); b.Valid {
+		t.Fatal("BoolFromPtr(nil) should be invalid")
+	}
+	v := true
+	if b := BoolFromPtr(&v); !b.Valid || !b.Bool {
+		t.Fatalf("BoolFromPtr(&true) = %+v, want valid true", b)
+	}
+}
+
+func TestBoolUnmarshalJSONTypeError(t *testing.T) {
+	var b Bool
+	err := json.Unmarshal([]byte("1"), &b)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestBoolUnmarshalJSONStrict(t *testing.T) {
+	var b Bool
+	if err := b.UnmarshalJSONStrict([]byte("false")); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !b.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat false as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var b2 Bool
+	if err := json.Unmarshal([]byte("false"), &b2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !b2.Valid {
+		t.Fatal("package-level Strict should treat false as valid")
+	}
+}
+
+func TestBoolIsZero(t *testing.T) {
+	if !NewBool(false, false).IsZero() {
+		t.Fatal("null Bool should be zero")
+	}
+	if !NewBool(false, true).IsZero() {
+		t.Fatal("valid false Bool should still be zero per package semantics")
+	}
+	if NewBool(true, true).IsZero() {
+		t.Fatal("valid true Bool should not be zero")
+	}
+}
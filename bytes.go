@@ -0,0 +1,123 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/grippy/null/internal"
+)
+
+// Bytes is a nullable []byte. There is no sql.Null type for byte slices,
+// so Bytes tracks validity itself.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is empty.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, len(b) > 0)
+}
+
+// BytesFromPtr creates a new Bytes that be null if b is nil or empty.
+// The returned Bytes holds *b and shares its backing array.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return BytesFrom(*b)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a null byte slice when this Bytes is null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.Bytes)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64-encoded string and null input, and a {"Bytes":..,
+// "Valid":..} object shape. Empty input produces a null Bytes, unless
+// Strict (or UnmarshalJSONStrict) says otherwise.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	return b.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats empty bytes as
+// valid rather than null, regardless of the package-level Strict setting.
+func (b *Bytes) UnmarshalJSONStrict(data []byte) error {
+	return b.unmarshalJSON(data, true)
+}
+
+func (b *Bytes) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch v.(type) {
+	case string:
+		if err := json.Unmarshal(data, &b.Bytes); err != nil {
+			return err
+		}
+		b.Valid = strict || len(b.Bytes) > 0
+		return nil
+	case map[string]interface{}:
+		var raw struct {
+			Bytes []byte
+			Valid bool
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		b.Bytes = raw.Bytes
+		b.Valid = raw.Valid && (strict || len(b.Bytes) > 0)
+		return nil
+	case nil:
+		b.Bytes = nil
+		b.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*b))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this Bytes is null.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bytes if the input is blank.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	b.Bytes = append(b.Bytes[0:0], text...)
+	b.Valid = len(b.Bytes) > 0
+	return nil
+}
+
+// Ptr returns a pointer to this Bytes' value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null or empty Bytes, for future omitempty support.
+func (b Bytes) IsZero() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
@@ -0,0 +1,77 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBytesRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "null"},
+		{"empty", `""`, false, "null"},
+		{"non-zero", `"aGVsbG8="`, true, `"aGVsbG8="`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b Bytes
+			if err := json.Unmarshal([]byte(c.input), &b); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if b.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", b.Valid, c.valid)
+			}
+			out, err := json.Marshal(b)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestBytesFromPtr(t *testing.T) {
+	if b := BytesFromPtr(nil); b.Valid {
+		t.Fatal("BytesFromPtr(nil) should be invalid")
+	}
+	v := []byte("hello")
+	if b := BytesFromPtr(&v); !b.Valid || string(b.Bytes) != "hello" {
+		t.Fatalf("BytesFromPtr(&v) = %+v, want valid hello", b)
+	}
+}
+
+func TestBytesUnmarshalJSONTypeError(t *testing.T) {
+	var b Bytes
+	err := json.Unmarshal([]byte("true"), &b)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestBytesUnmarshalJSONStrict(t *testing.T) {
+	var b Bytes
+	if err := b.UnmarshalJSONStrict([]byte(`""`)); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !b.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat empty bytes as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var b2 Bytes
+	if err := json.Unmarshal([]byte(`""`), &b2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !b2.Valid {
+		t.Fatal("package-level Strict should treat empty bytes as valid")
+	}
+}
@@ -0,0 +1,133 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Float is a nullable float64.
+type Float struct {
+	sql.NullFloat64
+}
+
+// NewFloat creates a new Float
+func NewFloat(f float64, valid bool) Float {
+	return Float{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   valid,
+		},
+	}
+}
+
+// FloatFrom creates a new Float that will be null if f is zero.
+func FloatFrom(f float64) Float {
+	return NewFloat(f, f != 0)
+}
+
+// FloatFromPtr creates a new Float that be null if f is nil or zero.
+// The returned Float holds a copy of *f.
+func FloatFromPtr(f *float64) Float {
+	if f == nil {
+		return NewFloat(0, false)
+	}
+	return FloatFrom(*f)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 when this Float is null.
+func (f Float) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return json.Marshal(f.Float64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input. Zero input produces a null Float,
+// unless Strict (or UnmarshalJSONStrict) says otherwise. It also supports
+// unmarshalling a sql.NullFloat64.
+func (f *Float) UnmarshalJSON(data []byte) error {
+	return f.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats a zero JSON number
+// as valid rather than null, regardless of the package-level Strict
+// setting.
+func (f *Float) UnmarshalJSONStrict(data []byte) error {
+	return f.unmarshalJSON(data, true)
+}
+
+func (f *Float) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, err := x.Float64()
+		if err != nil {
+			return err
+		}
+		f.Float64 = n
+		f.Valid = strict || f.Float64 != 0
+		return nil
+	case map[string]interface{}:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&f.NullFloat64); err != nil {
+			return err
+		}
+		f.Valid = f.Valid && (strict || f.Float64 != 0)
+		return nil
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*f))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero value when this Float is null.
+func (f Float) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float if the input is a blank or zero string.
+func (f *Float) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		f.Float64 = 0
+		f.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+	f.Float64 = n
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Float's value, or a nil pointer if this Float is null.
+func (f Float) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// IsZero returns true for null or zero Floats, for future omitempty support.
+func (f Float) IsZero() bool {
+	return !f.Valid || f.Float64 == 0
+}
@@ -0,0 +1,77 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFloatRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "0"},
+		{"zero", "0", false, "0"},
+		{"non-zero", "3.25", true, "3.25"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var f Float
+			if err := json.Unmarshal([]byte(c.input), &f); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if f.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", f.Valid, c.valid)
+			}
+			out, err := json.Marshal(f)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestFloatFromPtr(t *testing.T) {
+	if f := FloatFromPtr(nil); f.Valid {
+		t.Fatal("FloatFromPtr(nil) should be invalid")
+	}
+	n := 1.5
+	if f := FloatFromPtr(&n); !f.Valid || f.Float64 != 1.5 {
+		t.Fatalf("FloatFromPtr(&1.5) = %+v, want valid 1.5", f)
+	}
+}
+
+func TestFloatUnmarshalJSONTypeError(t *testing.T) {
+	var f Float
+	err := json.Unmarshal([]byte("true"), &f)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestFloatUnmarshalJSONStrict(t *testing.T) {
+	var f Float
+	if err := f.UnmarshalJSONStrict([]byte("0")); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !f.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat a zero number as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var f2 Float
+	if err := json.Unmarshal([]byte("0"), &f2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !f2.Valid {
+		t.Fatal("package-level Strict should treat a zero number as valid")
+	}
+}
@@ -0,0 +1,133 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Int is a nullable int32.
+type Int struct {
+	sql.NullInt32
+}
+
+// NewInt creates a new Int
+func NewInt(i int32, valid bool) Int {
+	return Int{
+		NullInt32: sql.NullInt32{
+			Int32: i,
+			Valid: valid,
+		},
+	}
+}
+
+// IntFrom creates a new Int that will be null if i is zero.
+func IntFrom(i int32) Int {
+	return NewInt(i, i != 0)
+}
+
+// IntFromPtr creates a new Int that be null if i is nil or zero.
+// The returned Int holds a copy of *i.
+func IntFromPtr(i *int32) Int {
+	if i == nil {
+		return NewInt(0, false)
+	}
+	return IntFrom(*i)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 when this Int is null.
+func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return json.Marshal(i.Int32)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input. Zero input produces a null Int,
+// unless Strict (or UnmarshalJSONStrict) says otherwise. It also supports
+// unmarshalling a sql.NullInt32.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	return i.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats a zero JSON number
+// as valid rather than null, regardless of the package-level Strict
+// setting.
+func (i *Int) UnmarshalJSONStrict(data []byte) error {
+	return i.unmarshalJSON(data, true)
+}
+
+func (i *Int) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, err := strconv.ParseInt(x.String(), 10, 32)
+		if err != nil {
+			return err
+		}
+		i.Int32 = int32(n)
+		i.Valid = strict || i.Int32 != 0
+		return nil
+	case map[string]interface{}:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&i.NullInt32); err != nil {
+			return err
+		}
+		i.Valid = i.Valid && (strict || i.Int32 != 0)
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*i))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero value when this Int is null.
+func (i Int) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int if the input is a blank or zero string.
+func (i *Int) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		i.Int32 = 0
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+	i.Int32 = int32(n)
+	i.Valid = i.Int32 != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
+func (i Int) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support.
+func (i Int) IsZero() bool {
+	return !i.Valid || i.Int32 == 0
+}
@@ -0,0 +1,133 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Int64 is a nullable int64.
+type Int64 struct {
+	sql.NullInt64
+}
+
+// NewInt64 creates a new Int64
+func NewInt64(i int64, valid bool) Int64 {
+	return Int64{
+		NullInt64: sql.NullInt64{
+			Int64: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int64From creates a new Int64 that will be null if i is zero.
+func Int64From(i int64) Int64 {
+	return NewInt64(i, i != 0)
+}
+
+// Int64FromPtr creates a new Int64 that be null if i is nil or zero.
+// The returned Int64 holds a copy of *i.
+func Int64FromPtr(i *int64) Int64 {
+	if i == nil {
+		return NewInt64(0, false)
+	}
+	return Int64From(*i)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 when this Int64 is null.
+func (i Int64) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return json.Marshal(i.Int64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input. Zero input produces a null Int64,
+// unless Strict (or UnmarshalJSONStrict) says otherwise. It also supports
+// unmarshalling a sql.NullInt64.
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	return i.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats a zero JSON number
+// as valid rather than null, regardless of the package-level Strict
+// setting.
+func (i *Int64) UnmarshalJSONStrict(data []byte) error {
+	return i.unmarshalJSON(data, true)
+}
+
+func (i *Int64) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, err := x.Int64()
+		if err != nil {
+			return err
+		}
+		i.Int64 = n
+		i.Valid = strict || i.Int64 != 0
+		return nil
+	case map[string]interface{}:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&i.NullInt64); err != nil {
+			return err
+		}
+		i.Valid = i.Valid && (strict || i.Int64 != 0)
+		return nil
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*i))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero value when this Int64 is null.
+func (i Int64) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int64 if the input is a blank or zero string.
+func (i *Int64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		i.Int64 = 0
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	i.Int64 = n
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Int64's value, or a nil pointer if this Int64 is null.
+func (i Int64) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+// IsZero returns true for null or zero Int64s, for future omitempty support.
+func (i Int64) IsZero() bool {
+	return !i.Valid || i.Int64 == 0
+}
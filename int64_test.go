@@ -0,0 +1,77 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestInt64RoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "0"},
+		{"zero", "0", false, "0"},
+		{"non-zero", "9223372036854775", true, "9223372036854775"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var i Int64
+			if err := json.Unmarshal([]byte(c.input), &i); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if i.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", i.Valid, c.valid)
+			}
+			out, err := json.Marshal(i)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestInt64FromPtr(t *testing.T) {
+	if i := Int64FromPtr(nil); i.Valid {
+		t.Fatal("Int64FromPtr(nil) should be invalid")
+	}
+	n := int64(7)
+	if i := Int64FromPtr(&n); !i.Valid || i.Int64 != 7 {
+		t.Fatalf("Int64FromPtr(&7) = %+v, want valid 7", i)
+	}
+}
+
+func TestInt64UnmarshalJSONTypeError(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal([]byte("true"), &i)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestInt64UnmarshalJSONStrict(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSONStrict([]byte("0")); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !i.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat a zero number as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var i2 Int64
+	if err := json.Unmarshal([]byte("0"), &i2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !i2.Valid {
+		t.Fatal("package-level Strict should treat a zero number as valid")
+	}
+}
@@ -0,0 +1,97 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIntRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "0"},
+		{"zero", "0", false, "0"},
+		{"non-zero", "42", true, "42"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var i Int
+			if err := json.Unmarshal([]byte(c.input), &i); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if i.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", i.Valid, c.valid)
+			}
+			out, err := json.Marshal(i)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestIntFromPtr(t *testing.T) {
+	if i := IntFromPtr(nil); i.Valid {
+		t.Fatal("IntFromPtr(nil) should be invalid")
+	}
+	n := int32(7)
+	if i := IntFromPtr(&n); !i.Valid || i.Int32 != 7 {
+		t.Fatalf("IntFromPtr(&7) = %+v, want valid 7", i)
+	}
+}
+
+func TestIntUnmarshalJSONOverflow(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte("5000000000"), &i)
+	if err == nil {
+		t.Fatalf("expected an error for an int32 overflow, got i = %+v", i)
+	}
+}
+
+func TestIntUnmarshalJSONTypeError(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte("true"), &i)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestIntUnmarshalJSONStrict(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalJSONStrict([]byte("0")); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !i.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat a zero number as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var i2 Int
+	if err := json.Unmarshal([]byte("0"), &i2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !i2.Valid {
+		t.Fatal("package-level Strict should treat a zero number as valid")
+	}
+}
+
+func TestIntIsZero(t *testing.T) {
+	if !NewInt(0, false).IsZero() {
+		t.Fatal("null Int should be zero")
+	}
+	if !NewInt(0, true).IsZero() {
+		t.Fatal("valid zero Int should still be zero per package semantics")
+	}
+	if NewInt(1, true).IsZero() {
+		t.Fatal("valid non-zero Int should not be zero")
+	}
+}
@@ -0,0 +1,39 @@
+// Package internal holds JSON parsing helpers shared by the null and
+// nuller packages so they don't duplicate the same decode-and-switch
+// logic in every type file.
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Decode unmarshals data using a json.Decoder configured with UseNumber,
+// so numeric JSON values decode as json.Number instead of float64 and
+// don't silently lose precision. It returns the dynamic value alongside a
+// short, human-readable name for its kind ("null", "string", "number",
+// "bool", "object", or "array") for callers that build error messages.
+func Decode(data []byte) (v interface{}, kind string, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&v); err != nil {
+		return nil, "", err
+	}
+	switch v.(type) {
+	case nil:
+		kind = "null"
+	case string:
+		kind = "string"
+	case json.Number:
+		kind = "number"
+	case bool:
+		kind = "bool"
+	case map[string]interface{}:
+		kind = "object"
+	case []interface{}:
+		kind = "array"
+	default:
+		kind = "invalid"
+	}
+	return v, kind, nil
+}
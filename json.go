@@ -0,0 +1,90 @@
+package null
+
+import (
+	"encoding/json"
+)
+
+// JSON is a nullable, raw-preserved JSON value. There is no sql.Null type
+// for json.RawMessage, so JSON tracks validity itself.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON creates a new JSON
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{
+		JSON:  b,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON that will be null if b is empty.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, len(b) > 0)
+}
+
+// JSONFromPtr creates a new JSON that be null if b is nil or empty.
+// The returned JSON holds *b and shares its backing array.
+func JSONFromPtr(b *[]byte) JSON {
+	if b == nil {
+		return NewJSON(nil, false)
+	}
+	return JSONFrom(*b)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a JSON null when this JSON is null, preserving the raw
+// bytes otherwise.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It preserves the raw input bytes. A JSON null or empty input produces a
+// null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || string(data) == "null" {
+		j.JSON = nil
+		j.Valid = false
+		return nil
+	}
+	j.JSON = append(j.JSON[0:0], data...)
+	j.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this JSON is null.
+func (j JSON) MarshalText() ([]byte, error) {
+	if !j.Valid {
+		return []byte{}, nil
+	}
+	return j.JSON, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank.
+func (j *JSON) UnmarshalText(text []byte) error {
+	j.JSON = append(j.JSON[0:0], text...)
+	j.Valid = len(j.JSON) > 0
+	return nil
+}
+
+// Ptr returns a pointer to this JSON's raw bytes, or a nil pointer if this
+// JSON is null.
+func (j JSON) Ptr() *[]byte {
+	if !j.Valid {
+		return nil
+	}
+	b := []byte(j.JSON)
+	return &b
+}
+
+// IsZero returns true for null or empty JSON, for future omitempty support.
+func (j JSON) IsZero() bool {
+	return !j.Valid || len(j.JSON) == 0
+}
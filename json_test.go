@@ -0,0 +1,47 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "null"},
+		{"empty", "", false, "null"},
+		{"non-zero", `{"a":1}`, true, `{"a":1}`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var j JSON
+			if err := j.UnmarshalJSON([]byte(c.input)); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if j.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", j.Valid, c.valid)
+			}
+			out, err := json.Marshal(j)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestJSONFromPtr(t *testing.T) {
+	if j := JSONFromPtr(nil); j.Valid {
+		t.Fatal("JSONFromPtr(nil) should be invalid")
+	}
+	v := []byte(`{"a":1}`)
+	if j := JSONFromPtr(&v); !j.Valid || string(j.JSON) != `{"a":1}` {
+		t.Fatalf("JSONFromPtr(&v) = %+v, want valid {\"a\":1}", j)
+	}
+}
@@ -0,0 +1,110 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Bool is a nullable bool. Unlike null.Bool, false is valid; only JSON
+// null is invalid.
+type Bool struct {
+	sql.NullBool
+}
+
+// NewBool creates a new Bool
+func NewBool(b bool, valid bool) Bool {
+	return Bool{
+		NullBool: sql.NullBool{
+			Bool:  b,
+			Valid: valid,
+		},
+	}
+}
+
+// BoolFrom creates a new valid Bool. It will never be null.
+func BoolFrom(b bool) Bool {
+	return NewBool(b, true)
+}
+
+// BoolFromPtr creates a new Bool that will be null if b is nil.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return NewBool(false, false)
+	}
+	return NewBool(*b, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.Bool)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports bool and null input, and a sql.NullBool object shape.
+// False input produces a valid Bool; only JSON null is invalid.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case bool:
+		b.Bool = x
+		b.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &b.NullBool)
+	case nil:
+		b.Bool = false
+		b.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*b))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this Bool is null.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return nil, ErrNullText
+	}
+	return []byte(strconv.FormatBool(b.Bool)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting Bool is always valid.
+func (b *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.Bool = false
+		b.Valid = true
+		return nil
+	}
+	v, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return err
+	}
+	b.Bool = v
+	b.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
+func (b Bool) Ptr() *bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bool
+}
+
+// IsZero returns true only when this Bool is null.
+func (b Bool) IsZero() bool {
+	return !b.Valid
+}
@@ -0,0 +1,27 @@
+package nuller
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// UnmarshalTypeError reports that UnmarshalJSON was given a JSON value of
+// a kind this package's nullable types don't support (for example, a JSON
+// array where a string or number was expected). It wraps the standard
+// library's json.UnmarshalTypeError so callers can still match against it
+// with errors.As.
+type UnmarshalTypeError struct {
+	*json.UnmarshalTypeError
+}
+
+// Unwrap returns the wrapped *json.UnmarshalTypeError.
+func (e *UnmarshalTypeError) Unwrap() error {
+	return e.UnmarshalTypeError
+}
+
+// newUnmarshalTypeError builds an UnmarshalTypeError reporting that a
+// JSON value of the given kind (e.g. "number", "bool", "array") couldn't
+// be unmarshalled into t.
+func newUnmarshalTypeError(kind string, t reflect.Type) *UnmarshalTypeError {
+	return &UnmarshalTypeError{&json.UnmarshalTypeError{Value: kind, Type: t}}
+}
@@ -0,0 +1,114 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Float is a nullable float64. Unlike null.Float, zero is valid; only
+// JSON null is invalid.
+type Float struct {
+	sql.NullFloat64
+}
+
+// NewFloat creates a new Float
+func NewFloat(f float64, valid bool) Float {
+	return Float{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   valid,
+		},
+	}
+}
+
+// FloatFrom creates a new valid Float. It will never be null.
+func FloatFrom(f float64) Float {
+	return NewFloat(f, true)
+}
+
+// FloatFromPtr creates a new Float that will be null if f is nil.
+func FloatFromPtr(f *float64) Float {
+	if f == nil {
+		return NewFloat(0, false)
+	}
+	return NewFloat(*f, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(f.Float64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input, and a sql.NullFloat64 object shape.
+// Zero input produces a valid Float; only JSON null is invalid.
+func (f *Float) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, nerr := x.Float64()
+		if nerr != nil {
+			return nerr
+		}
+		f.Float64 = n
+		f.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &f.NullFloat64)
+	case nil:
+		f.Float64 = 0
+		f.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*f))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this Float is null.
+func (f Float) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return nil, ErrNullText
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting Float is always valid.
+func (f *Float) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		f.Float64 = 0
+		f.Valid = true
+		return nil
+	}
+	n, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+	f.Float64 = n
+	f.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Float's value, or a nil pointer if this Float is null.
+func (f Float) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// IsZero returns true only when this Float is null.
+func (f Float) IsZero() bool {
+	return !f.Valid
+}
@@ -0,0 +1,114 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Int is a nullable int32. Unlike null.Int, zero is valid; only JSON null
+// is invalid.
+type Int struct {
+	sql.NullInt32
+}
+
+// NewInt creates a new Int
+func NewInt(i int32, valid bool) Int {
+	return Int{
+		NullInt32: sql.NullInt32{
+			Int32: i,
+			Valid: valid,
+		},
+	}
+}
+
+// IntFrom creates a new valid Int. It will never be null.
+func IntFrom(i int32) Int {
+	return NewInt(i, true)
+}
+
+// IntFromPtr creates a new Int that will be null if i is nil.
+func IntFromPtr(i *int32) Int {
+	if i == nil {
+		return NewInt(0, false)
+	}
+	return NewInt(*i, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int32)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input, and a sql.NullInt32 object shape.
+// Zero input produces a valid Int; only JSON null is invalid.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, nerr := strconv.ParseInt(x.String(), 10, 32)
+		if nerr != nil {
+			return nerr
+		}
+		i.Int32 = int32(n)
+		i.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt32)
+	case nil:
+		i.Int32 = 0
+		i.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*i))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this Int is null.
+func (i Int) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nil, ErrNullText
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting Int is always valid.
+func (i *Int) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		i.Int32 = 0
+		i.Valid = true
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+	i.Int32 = int32(n)
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
+func (i Int) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// IsZero returns true only when this Int is null.
+func (i Int) IsZero() bool {
+	return !i.Valid
+}
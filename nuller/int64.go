@@ -0,0 +1,114 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/grippy/null/internal"
+)
+
+// Int64 is a nullable int64. Unlike null.Int64, zero is valid; only JSON
+// null is invalid.
+type Int64 struct {
+	sql.NullInt64
+}
+
+// NewInt64 creates a new Int64
+func NewInt64(i int64, valid bool) Int64 {
+	return Int64{
+		NullInt64: sql.NullInt64{
+			Int64: i,
+			Valid: valid,
+		},
+	}
+}
+
+// Int64From creates a new valid Int64. It will never be null.
+func Int64From(i int64) Int64 {
+	return NewInt64(i, true)
+}
+
+// Int64FromPtr creates a new Int64 that will be null if i is nil.
+func Int64FromPtr(i *int64) Int64 {
+	if i == nil {
+		return NewInt64(0, false)
+	}
+	return NewInt64(*i, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int64 is null.
+func (i Int64) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.Int64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input, and a sql.NullInt64 object shape.
+// Zero input produces a valid Int64; only JSON null is invalid.
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case json.Number:
+		n, nerr := x.Int64()
+		if nerr != nil {
+			return nerr
+		}
+		i.Int64 = n
+		i.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &i.NullInt64)
+	case nil:
+		i.Int64 = 0
+		i.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*i))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this Int64 is null.
+func (i Int64) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return nil, ErrNullText
+	}
+	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting Int64 is always valid.
+func (i *Int64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		i.Int64 = 0
+		i.Valid = true
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	i.Int64 = n
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Int64's value, or a nil pointer if this Int64 is null.
+func (i Int64) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+// IsZero returns true only when this Int64 is null.
+func (i Int64) IsZero() bool {
+	return !i.Valid
+}
@@ -0,0 +1,15 @@
+// Package nuller provides nullable types with strict, never-collapsing
+// null semantics: unlike the null package, a zero value (blank string, 0,
+// false, the zero time, empty bytes) is considered valid. Only an explicit
+// JSON null, or an unset value, is treated as invalid, and MarshalJSON
+// encodes that as a literal null rather than the zero value.
+package nuller
+
+import "errors"
+
+// ErrNullText is returned by MarshalText when the receiver is null. Unlike
+// JSON, there is no textual encoding that can distinguish null from a
+// blank string on the way back in, so callers that need to detect null
+// through encoding.TextMarshaler (e.g. encoding/xml) must check for this
+// error instead.
+var ErrNullText = errors.New("nuller: value is null")
@@ -0,0 +1,281 @@
+package nuller
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	stdtime "time"
+)
+
+func TestStringRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, "null"},
+		{"empty", `""`, true, `""`},
+		{"zero", `""`, true, `""`},
+		{"non-zero", `"hi"`, true, `"hi"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var s String
+			if err := json.Unmarshal([]byte(c.input), &s); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if s.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", s.Valid, c.valid)
+			}
+			out, err := json.Marshal(s)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestIntRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"null", "null", false},
+		{"zero", "0", true},
+		{"non-zero", "42", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var i Int
+			if err := json.Unmarshal([]byte(c.input), &i); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if i.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", i.Valid, c.valid)
+			}
+			out, err := json.Marshal(i)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if i.Valid && string(out) == "null" {
+				t.Fatalf("valid Int marshalled as null")
+			}
+			if !i.Valid && string(out) != "null" {
+				t.Fatalf("null Int marshalled as %s, want null", out)
+			}
+		})
+	}
+}
+
+func TestInt64RoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"null", "null", false},
+		{"zero", "0", true},
+		{"non-zero", "9223372036854775", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var i Int64
+			if err := json.Unmarshal([]byte(c.input), &i); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if i.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", i.Valid, c.valid)
+			}
+			out, err := json.Marshal(i)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if i.Valid && string(out) == "null" {
+				t.Fatalf("valid Int64 marshalled as null")
+			}
+			if !i.Valid && string(out) != "null" {
+				t.Fatalf("null Int64 marshalled as %s, want null", out)
+			}
+		})
+	}
+}
+
+func TestFloatRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"null", "null", false},
+		{"zero", "0", true},
+		{"non-zero", "3.25", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var f Float
+			if err := json.Unmarshal([]byte(c.input), &f); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if f.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", f.Valid, c.valid)
+			}
+			out, err := json.Marshal(f)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if f.Valid && string(out) == "null" {
+				t.Fatalf("valid Float marshalled as null")
+			}
+			if !f.Valid && string(out) != "null" {
+				t.Fatalf("null Float marshalled as %s, want null", out)
+			}
+		})
+	}
+}
+
+func TestBoolRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"null", "null", false},
+		{"zero", "false", true},
+		{"non-zero", "true", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b Bool
+			if err := json.Unmarshal([]byte(c.input), &b); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if b.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", b.Valid, c.valid)
+			}
+			out, err := json.Marshal(b)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if b.Valid && string(out) == "null" {
+				t.Fatalf("valid Bool marshalled as null")
+			}
+			if !b.Valid && string(out) != "null" {
+				t.Fatalf("null Bool marshalled as %s, want null", out)
+			}
+		})
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	var zero Time
+	if err := json.Unmarshal([]byte("null"), &zero); err != nil {
+		t.Fatalf("unmarshal null: %v", err)
+	}
+	if zero.Valid {
+		t.Fatal("Valid should be false for null input")
+	}
+
+	now := TimeFrom(stdtime.Now())
+	out, err := json.Marshal(now)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var back Time
+	if err := json.Unmarshal(out, &back); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !back.Valid || !back.Time.Equal(now.Time) {
+		t.Fatalf("round trip mismatch: got %v, want %v", back.Time, now.Time)
+	}
+
+	var zeroTime Time
+	zeroTime.Time = stdtime.Time{}
+	zeroTime.Valid = true
+	zOut, err := json.Marshal(zeroTime)
+	if err != nil {
+		t.Fatalf("marshal zero time: %v", err)
+	}
+	if string(zOut) == "null" {
+		t.Fatal("valid zero-value Time marshalled as null")
+	}
+}
+
+func TestStringMarshalTextNull(t *testing.T) {
+	var s String
+	if _, err := s.MarshalText(); err != ErrNullText {
+		t.Fatalf("MarshalText error = %v, want ErrNullText", err)
+	}
+}
+
+func TestStringUnmarshalJSONTypeError(t *testing.T) {
+	var s String
+	err := json.Unmarshal([]byte("42"), &s)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestIntUnmarshalJSONTypeError(t *testing.T) {
+	var i Int
+	err := json.Unmarshal([]byte("true"), &i)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestInt64UnmarshalJSONTypeError(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal([]byte("true"), &i)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestFloatUnmarshalJSONTypeError(t *testing.T) {
+	var f Float
+	err := json.Unmarshal([]byte("true"), &f)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestBoolUnmarshalJSONTypeError(t *testing.T) {
+	var b Bool
+	err := json.Unmarshal([]byte("1"), &b)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestTimeUnmarshalJSONTypeError(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal([]byte("42"), &ti)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestTimeUnmarshalTextEmpty(t *testing.T) {
+	var ti Time
+	if err := ti.UnmarshalText([]byte{}); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !ti.Valid {
+		t.Fatal("UnmarshalText should treat blank input as valid")
+	}
+	if !ti.Time.IsZero() {
+		t.Fatalf("Time = %v, want the zero time", ti.Time)
+	}
+}
+
@@ -0,0 +1,100 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+
+	"github.com/grippy/null/internal"
+)
+
+// String is a nullable string. Unlike null.String, a blank string is
+// valid; only JSON null is invalid.
+type String struct {
+	sql.NullString
+}
+
+// NewString creates a new String
+func NewString(s string, valid bool) String {
+	return String{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// StringFrom creates a new valid String. It will never be null.
+func StringFrom(s string) String {
+	return NewString(s, true)
+}
+
+// StringFromPtr creates a new String that will be null if s is nil.
+func StringFromPtr(s *string) String {
+	if s == nil {
+		return NewString("", false)
+	}
+	return NewString(*s, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.String)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, and a sql.NullString object shape.
+// A JSON "" produces a valid, empty String; only JSON null is invalid.
+func (s *String) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		s.String = x
+		s.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &s.NullString)
+	case nil:
+		s.String = ""
+		s.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*s))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this String is null.
+func (s String) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return nil, ErrNullText
+	}
+	return []byte(s.String), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting String is always valid, including for blank input.
+func (s *String) UnmarshalText(text []byte) error {
+	s.String = string(text)
+	s.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
+func (s String) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// IsZero returns true only when this String is null.
+func (s String) IsZero() bool {
+	return !s.Valid
+}
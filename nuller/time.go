@@ -0,0 +1,109 @@
+package nuller
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	stdtime "time"
+
+	"github.com/grippy/null/internal"
+)
+
+// Time is a nullable time.Time. Unlike null.Time, the zero time is valid;
+// only JSON null is invalid.
+type Time struct {
+	sql.NullTime
+}
+
+// NewTime creates a new Time
+func NewTime(t stdtime.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// TimeFrom creates a new valid Time. It will never be null.
+func TimeFrom(t stdtime.Time) Time {
+	return NewTime(t, true)
+}
+
+// TimeFromPtr creates a new Time that will be null if t is nil.
+func TimeFromPtr(t *stdtime.Time) Time {
+	if t == nil {
+		return NewTime(stdtime.Time{}, false)
+	}
+	return NewTime(*t, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Time is null, and RFC3339Nano otherwise.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports RFC3339Nano string and null input, and a sql.NullTime
+// object shape. Only JSON null is invalid.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch v.(type) {
+	case string:
+		if err = t.Time.UnmarshalJSON(data); err == nil {
+			t.Valid = true
+		}
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &t.NullTime)
+	case nil:
+		t.Time = stdtime.Time{}
+		t.Valid = false
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*t))
+	}
+	return err
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns ErrNullText if this Time is null.
+func (t Time) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return nil, ErrNullText
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The resulting Time is always valid.
+func (t *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.Time = stdtime.Time{}
+		t.Valid = true
+		return nil
+	}
+	if err := t.Time.UnmarshalText(text); err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
+func (t Time) Ptr() *stdtime.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsZero returns true only when this Time is null.
+func (t Time) IsZero() bool {
+	return !t.Valid
+}
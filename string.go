@@ -5,8 +5,12 @@
 package null
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
+	"reflect"
+
+	"github.com/grippy/null/internal"
 )
 
 // String is a nullable string.
@@ -39,24 +43,54 @@ func StringFromPtr(s *string) String {
 	return str
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string when this String is null.
+func (s String) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(s.String)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string and null input. Blank string input produces a null String.
-// It also supports unmarshalling a sql.NullString.
+// It supports string and null input. Blank string input produces a null
+// String, unless Strict (or UnmarshalJSONStrict) says otherwise. It also
+// supports unmarshalling a sql.NullString.
 func (s *String) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	json.Unmarshal(data, &v)
+	return s.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats a blank JSON
+// string as valid rather than null, regardless of the package-level
+// Strict setting.
+func (s *String) UnmarshalJSONStrict(data []byte) error {
+	return s.unmarshalJSON(data, true)
+}
+
+func (s *String) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
 	switch x := v.(type) {
 	case string:
 		s.String = x
+		s.Valid = strict || s.String != ""
+		return nil
 	case map[string]interface{}:
-		err = json.Unmarshal(data, &s.NullString)
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&s.NullString); err != nil {
+			return err
+		}
+		s.Valid = s.Valid && (strict || s.String != "")
+		return nil
 	case nil:
 		s.Valid = false
 		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*s))
 	}
-	s.Valid = (err == nil) && (s.String != "")
-	return err
 }
 
 // MarshalText implements encoding.TextMarshaler.
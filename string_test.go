@@ -0,0 +1,44 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestStringUnmarshalJSONTypeError(t *testing.T) {
+	var s String
+	err := json.Unmarshal([]byte("42"), &s)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestStringUnmarshalJSONPropagatesDecodeError(t *testing.T) {
+	var s String
+	err := s.UnmarshalJSON([]byte(`{"String":`))
+	if err == nil {
+		t.Fatal("expected an error for truncated JSON")
+	}
+}
+
+func TestStringUnmarshalJSONStrict(t *testing.T) {
+	var s String
+	if err := s.UnmarshalJSONStrict([]byte(`""`)); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !s.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat a blank string as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var s2 String
+	if err := json.Unmarshal([]byte(`""`), &s2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !s2.Valid {
+		t.Fatal("package-level Strict should treat a blank string as valid")
+	}
+}
@@ -0,0 +1,128 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	stdtime "time"
+
+	"github.com/grippy/null/internal"
+)
+
+// Time is a nullable time.Time.
+type Time struct {
+	sql.NullTime
+}
+
+// NewTime creates a new Time
+func NewTime(t stdtime.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// TimeFrom creates a new Time that will be null if t is the zero time.
+func TimeFrom(t stdtime.Time) Time {
+	return NewTime(t, !t.IsZero())
+}
+
+// TimeFromPtr creates a new Time that be null if t is nil or the zero time.
+// The returned Time holds a copy of *t.
+func TimeFromPtr(t *stdtime.Time) Time {
+	if t == nil {
+		return NewTime(stdtime.Time{}, false)
+	}
+	return TimeFrom(*t)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero time when this Time is null, in RFC3339Nano format.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return stdtime.Time{}.MarshalJSON()
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports RFC3339Nano string and null input. The zero time produces a
+// null Time, unless Strict (or UnmarshalJSONStrict) says otherwise. It
+// also supports unmarshalling a sql.NullTime.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, Strict)
+}
+
+// UnmarshalJSONStrict is like UnmarshalJSON, but treats the zero time as
+// valid rather than null, regardless of the package-level Strict setting.
+func (t *Time) UnmarshalJSONStrict(data []byte) error {
+	return t.unmarshalJSON(data, true)
+}
+
+func (t *Time) unmarshalJSON(data []byte, strict bool) error {
+	v, kind, err := internal.Decode(data)
+	if err != nil {
+		return err
+	}
+	switch v.(type) {
+	case string:
+		if err := t.Time.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		t.Valid = strict || !t.Time.IsZero()
+		return nil
+	case map[string]interface{}:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&t.NullTime); err != nil {
+			return err
+		}
+		t.Valid = t.Valid && (strict || !t.Time.IsZero())
+		return nil
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		return newUnmarshalTypeError(kind, reflect.TypeOf(*t))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the zero time when this Time is null, in RFC3339Nano format.
+func (t Time) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return stdtime.Time{}.MarshalText()
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Time if the input is a blank string.
+func (t *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		t.Time = stdtime.Time{}
+		t.Valid = false
+		return nil
+	}
+	if err := t.Time.UnmarshalText(text); err != nil {
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
+
+// Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
+func (t Time) Ptr() *stdtime.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsZero returns true for null or zero-value Times, for future omitempty support.
+func (t Time) IsZero() bool {
+	return !t.Valid || t.Time.IsZero()
+}
@@ -0,0 +1,95 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeRoundTrip(t *testing.T) {
+	zero := time.Time{}
+	nonZero := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	zeroJSON, err := zero.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal zero time: %v", err)
+	}
+	nonZeroJSON, err := nonZero.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal non-zero time: %v", err)
+	}
+
+	cases := []struct {
+		name  string
+		input string
+		valid bool
+		want  string
+	}{
+		{"null", "null", false, string(zeroJSON)},
+		{"zero", string(zeroJSON), false, string(zeroJSON)},
+		{"non-zero", string(nonZeroJSON), true, string(nonZeroJSON)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var ti Time
+			if err := json.Unmarshal([]byte(c.input), &ti); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if ti.Valid != c.valid {
+				t.Fatalf("Valid = %v, want %v", ti.Valid, c.valid)
+			}
+			out, err := json.Marshal(ti)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(out) != c.want {
+				t.Fatalf("marshal = %s, want %s", out, c.want)
+			}
+		})
+	}
+}
+
+func TestTimeFromPtr(t *testing.T) {
+	if ti := TimeFromPtr(nil); ti.Valid {
+		t.Fatal("TimeFromPtr(nil) should be invalid")
+	}
+	n := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	if ti := TimeFromPtr(&n); !ti.Valid || !ti.Time.Equal(n) {
+		t.Fatalf("TimeFromPtr(&n) = %+v, want valid %v", ti, n)
+	}
+}
+
+func TestTimeUnmarshalJSONTypeError(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal([]byte("42"), &ti)
+	var typeErr *UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("err = %v, want *UnmarshalTypeError", err)
+	}
+}
+
+func TestTimeUnmarshalJSONStrict(t *testing.T) {
+	zero := time.Time{}
+	zeroJSON, err := zero.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal zero time: %v", err)
+	}
+
+	var ti Time
+	if err := ti.UnmarshalJSONStrict(zeroJSON); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !ti.Valid {
+		t.Fatal("UnmarshalJSONStrict should treat the zero time as valid")
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+	var ti2 Time
+	if err := json.Unmarshal(zeroJSON, &ti2); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !ti2.Valid {
+		t.Fatal("package-level Strict should treat the zero time as valid")
+	}
+}